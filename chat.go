@@ -0,0 +1,205 @@
+package steam
+
+import (
+	pb "github.com/13k/go-steam-resources/protobuf/steam"
+	"github.com/13k/go-steam-resources/steamlang"
+	"github.com/13k/go-steam/protocol"
+	"github.com/13k/go-steam/steamid"
+	"google.golang.org/protobuf/proto"
+)
+
+// Chat handles Steam chat rooms: clan chats and, historically, direct "Friends" chats. It
+// resolves chat SteamIDs sent in clan form to the clan-chat form (see
+// steamid.SteamID.ClanToChat) so callers always see the same ID regardless of which message
+// carried it; IDs of any other account type are passed through unchanged.
+type Chat struct {
+	client *Client
+}
+
+var _ protocol.PacketHandler = (*Chat)(nil)
+
+func newChat(client *Client) *Chat {
+	return &Chat{client: client}
+}
+
+func (c *Chat) HandlePacket(packet *protocol.Packet) {
+	switch packet.EMsg() {
+	case steamlang.EMsg_ClientChatMsg:
+		c.handleChatMsg(packet)
+	case steamlang.EMsg_ClientChatMemberInfo:
+		c.handleChatMemberInfo(packet)
+	case steamlang.EMsg_ClientChatEnter:
+		c.handleChatEnter(packet)
+	case steamlang.EMsg_ClientChatInvite:
+		c.handleChatInvite(packet)
+	case steamlang.EMsg_ClientChatRoomInfo:
+		c.handleChatRoomInfo(packet)
+	}
+}
+
+// JoinChat joins the chat room (or clan chat) identified by chatID.
+func (c *Chat) JoinChat(chatID steamid.SteamID) {
+	c.client.Write(protocol.NewProtoMessage(steamlang.EMsg_ClientJoinChat, &pb.CMsgClientJoinChat{
+		SteamIdChat: proto.Uint64(uint64(chatID)),
+	}))
+}
+
+// LeaveChat leaves the chat room identified by chatID.
+func (c *Chat) LeaveChat(chatID steamid.SteamID) {
+	c.client.Write(protocol.NewProtoMessage(steamlang.EMsg_ClientChatLeave, &pb.CMsgClientChatLeave{
+		ChatId:         proto.Uint64(uint64(chatID)),
+		ChatIdentifier: proto.Uint64(uint64(chatID)),
+	}))
+}
+
+// SendChatMessage sends msg of the given entryType to the chat room or user identified by to.
+func (c *Chat) SendChatMessage(to steamid.SteamID, entryType steamlang.EChatEntryType, msg string) {
+	c.client.Write(protocol.NewProtoMessage(steamlang.EMsg_ClientChatMsg, &pb.CMsgClientChatMsg{
+		SteamIdChatRoom: proto.Uint64(uint64(to)),
+		SteamIdChatter:  proto.Uint64(uint64(c.client.SteamID())),
+		ChatMsgType:     proto.Uint32(uint32(entryType)),
+		Message:         []byte(msg),
+	}))
+}
+
+// KickChatMember kicks member from the chat room chatID.
+func (c *Chat) KickChatMember(chatID, member steamid.SteamID) {
+	c.client.Write(protocol.NewProtoMessage(steamlang.EMsg_ClientChatAction, &pb.CMsgClientChatAction{
+		SteamIdChat:        proto.Uint64(uint64(chatID)),
+		SteamIdUserToActOn: proto.Uint64(uint64(member)),
+		ChatAction:         proto.Uint32(uint32(steamlang.EChatAction_Kick)),
+	}))
+}
+
+// SetChatMemberStateChange sets member's rank/permissions in the chat room chatID. stateChange
+// mirrors the bits Steam itself sends in a ChatMemberInfoEvent.
+func (c *Chat) SetChatMemberStateChange(chatID, member steamid.SteamID, stateChange steamlang.EChatMemberStateChange) {
+	c.client.Write(protocol.NewProtoMessage(steamlang.EMsg_ClientChatAction, &pb.CMsgClientChatAction{
+		SteamIdChat:        proto.Uint64(uint64(chatID)),
+		SteamIdUserToActOn: proto.Uint64(uint64(member)),
+		ChatAction:         proto.Uint32(uint32(stateChange)),
+	}))
+}
+
+func (c *Chat) handleChatMsg(packet *protocol.Packet) {
+	msg := &pb.CMsgClientChatMsg{}
+
+	if _, err := packet.ReadProtoMsg(msg); err != nil {
+		c.client.Errorf("chat: error reading message: %v", err)
+		return
+	}
+
+	c.client.Emit(&ChatMsgEvent{
+		ChatRoomID: resolveChatID(steamid.SteamID(msg.GetSteamIdChatRoom())),
+		ChatterID:  steamid.SteamID(msg.GetSteamIdChatter()),
+		Message:    string(msg.GetMessage()),
+		EntryType:  steamlang.EChatEntryType(msg.GetChatMsgType()),
+	})
+}
+
+func (c *Chat) handleChatMemberInfo(packet *protocol.Packet) {
+	msg := &pb.CMsgClientChatMemberInfo{}
+
+	if _, err := packet.ReadProtoMsg(msg); err != nil {
+		c.client.Errorf("chat: error reading message: %v", err)
+		return
+	}
+
+	c.client.Emit(&ChatMemberInfoEvent{
+		ChatRoomID: resolveChatID(steamid.SteamID(msg.GetSteamIdChat())),
+		Type:       steamlang.EChatInfoType(msg.GetType()),
+	})
+}
+
+func (c *Chat) handleChatEnter(packet *protocol.Packet) {
+	msg := &pb.CMsgClientChatEnter{}
+
+	if _, err := packet.ReadProtoMsg(msg); err != nil {
+		c.client.Errorf("chat: error reading message: %v", err)
+		return
+	}
+
+	c.client.Emit(&ChatEnterEvent{
+		ChatRoomID:    resolveChatID(steamid.SteamID(msg.GetSteamIdChat())),
+		ClanID:        steamid.SteamID(msg.GetSteamIdClan()),
+		ChatRoomName:  msg.GetChatRoomName(),
+		EnterResponse: steamlang.EChatRoomEnterResponse(msg.GetEnterResponse()),
+	})
+}
+
+func (c *Chat) handleChatInvite(packet *protocol.Packet) {
+	msg := &pb.CMsgClientChatInvite{}
+
+	if _, err := packet.ReadProtoMsg(msg); err != nil {
+		c.client.Errorf("chat: error reading message: %v", err)
+		return
+	}
+
+	c.client.Emit(&ChatInviteEvent{
+		ChatRoomID: resolveChatID(steamid.SteamID(msg.GetSteamIdChat())),
+		PatronID:   steamid.SteamID(msg.GetSteamIdPatron()),
+		ChatName:   msg.GetChatName(),
+	})
+}
+
+func (c *Chat) handleChatRoomInfo(packet *protocol.Packet) {
+	msg := &pb.CMsgClientChatRoomInfo{}
+
+	if _, err := packet.ReadProtoMsg(msg); err != nil {
+		c.client.Errorf("chat: error reading message: %v", err)
+		return
+	}
+
+	c.client.Emit(&ChatRoomInfoEvent{
+		ChatRoomID: resolveChatID(steamid.SteamID(msg.GetSteamIdChat())),
+		Type:       steamlang.EChatInfoType(msg.GetType()),
+	})
+}
+
+// resolveChatID normalizes a chat SteamID sent for a clan chat into the clan-chat form, so
+// callers always see the same ID regardless of which message carried it.
+func resolveChatID(id steamid.SteamID) steamid.SteamID {
+	if id.GetAccountType() == steamlang.EAccountType_Clan {
+		return id.ClanToChat()
+	}
+
+	return id
+}
+
+// ChatMsgEvent is emitted when a chat message is received, either in a clan chat room
+// (ChatRoomID resolved via steamid.SteamID.ClanToChat) or a direct chat.
+type ChatMsgEvent struct {
+	ChatRoomID steamid.SteamID
+	ChatterID  steamid.SteamID
+	Message    string
+	EntryType  steamlang.EChatEntryType
+}
+
+// ChatMemberInfoEvent is emitted on membership changes (join/leave/kick/rank change) in a chat
+// room.
+type ChatMemberInfoEvent struct {
+	ChatRoomID steamid.SteamID
+	Type       steamlang.EChatInfoType
+}
+
+// ChatEnterEvent is emitted when a JoinChat call is answered, successfully or not.
+type ChatEnterEvent struct {
+	ChatRoomID    steamid.SteamID
+	ClanID        steamid.SteamID
+	ChatRoomName  string
+	EnterResponse steamlang.EChatRoomEnterResponse
+}
+
+// ChatInviteEvent is emitted when the bot is invited to a chat room.
+type ChatInviteEvent struct {
+	ChatRoomID steamid.SteamID
+	PatronID   steamid.SteamID
+	ChatName   string
+}
+
+// ChatRoomInfoEvent is emitted for out-of-band chat room info pushes (e.g. a topic or rules
+// change) that aren't membership changes (see ChatMemberInfoEvent) or messages.
+type ChatRoomInfoEvent struct {
+	ChatRoomID steamid.SteamID
+	Type       steamlang.EChatInfoType
+}
@@ -1,6 +1,7 @@
 package inventory
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -60,8 +61,28 @@ type Context struct {
 	Name       string
 }
 
-func GetInventoryApps(client *http.Client, steamID steamid.SteamID) (InventoryApps, error) {
-	resp, err := http.Get("http://steamcommunity.com/profiles/" + steamID.FormatString() + "/inventory/")
+func GetInventoryApps(session Session, steamID steamid.SteamID) (InventoryApps, error) {
+	return GetInventoryAppsCtx(context.Background(), session, steamID)
+}
+
+// GetInventoryAppsCtx is like GetInventoryApps, but the request is issued with ctx so the
+// caller can cancel it or attach a deadline.
+//
+// The request goes through session rather than a bare *http.Client so that private inventories
+// (the common case) are actually authenticated; an unauthenticated request silently gets back a
+// page with no inventory data instead of an error.
+func GetInventoryAppsCtx(
+	ctx context.Context,
+	session Session,
+	steamID steamid.SteamID,
+) (InventoryApps, error) {
+	req, err := session.NewRequest(ctx, http.MethodGet, "/profiles/"+steamID.FormatString()+"/inventory/", nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := session.Do(req)
 
 	if err != nil {
 		return nil, err
@@ -2,6 +2,7 @@ package inventory
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -27,8 +28,8 @@ func (m *MoreStart) UnmarshalJSON(data []byte) error {
 	return json.Unmarshal(data, (*uint)(m))
 }
 
-func PerformRequest(client *http.Client, req *http.Request) (*PartialInventory, error) {
-	resp, err := client.Do(req)
+func PerformRequest(session Session, req *http.Request) (*PartialInventory, error) {
+	resp, err := session.Do(req)
 
 	if err != nil {
 		return nil, err
@@ -45,14 +46,20 @@ func PerformRequest(client *http.Client, req *http.Request) (*PartialInventory,
 	return inv, nil
 }
 
-// TODO: use only one iterator function and indicate the first page with start = 0
-// type PartialInventoryFetcher func(start uint) (*PartialInventory, error)
+// PartialInventoryFetcher fetches one page of a paginated inventory. start is 0 for the first
+// page and the previous page's MoreStart for subsequent ones.
+type PartialInventoryFetcher func(ctx context.Context, start uint) (*PartialInventory, error)
 
-func GetFullInventory(
-	getFirst func() (*PartialInventory, error),
-	getNext func(start uint) (*PartialInventory, error),
-) (*Inventory, error) {
-	first, err := getFirst()
+func GetFullInventory(fetch func(start uint) (*PartialInventory, error)) (*Inventory, error) {
+	return GetFullInventoryCtx(context.Background(), func(_ context.Context, start uint) (*PartialInventory, error) {
+		return fetch(start)
+	})
+}
+
+// GetFullInventoryCtx is like GetFullInventory, but checks ctx between pages and aborts the
+// remaining pagination as soon as ctx is done.
+func GetFullInventoryCtx(ctx context.Context, fetch PartialInventoryFetcher) (*Inventory, error) {
+	first, err := fetch(ctx, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +70,13 @@ func GetFullInventory(
 	result := &first.Inventory
 	var next *PartialInventory
 	for latest := first; latest.More; latest = next {
-		next, err := getNext(uint(latest.MoreStart))
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var err error
+
+		next, err = fetch(ctx, uint(latest.MoreStart))
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,87 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/13k/go-steam/steamid"
+)
+
+// Session builds and executes authenticated requests against the Steam community website.
+// steam.Web.Inventory returns a Fetcher bound to a Session so callers don't have to thread
+// cookies through every inventory request by hand.
+type Session interface {
+	// NewRequest builds an *http.Request for method and path carrying the session's cookies.
+	// path is resolved against https://steamcommunity.com unless it is already absolute.
+	NewRequest(ctx context.Context, method, path string, body io.Reader) (*http.Request, error)
+
+	// Do executes req using the session's underlying *http.Client.
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Fetcher is a ready-to-use inventory client bound to a Session, returned by steam.Web.Inventory.
+type Fetcher struct {
+	Session Session
+}
+
+// NewFetcher returns a Fetcher that issues every request through session.
+func NewFetcher(session Session) *Fetcher {
+	return &Fetcher{Session: session}
+}
+
+// GetInventoryApps is like the package-level GetInventoryApps, using f.Session.
+func (f *Fetcher) GetInventoryApps(ctx context.Context, steamID steamid.SteamID) (InventoryApps, error) {
+	return GetInventoryAppsCtx(ctx, f.Session, steamID)
+}
+
+// GetFullInventory is like the package-level GetFullInventoryCtx, using f.Session to build and
+// perform each page request via buildPage.
+func (f *Fetcher) GetFullInventory(
+	ctx context.Context,
+	buildPage func(start uint) (*http.Request, error),
+) (*Inventory, error) {
+	return GetFullInventoryCtx(ctx, func(_ context.Context, start uint) (*PartialInventory, error) {
+		req, err := buildPage(start)
+		if err != nil {
+			return nil, err
+		}
+
+		return PerformRequest(f.Session, req)
+	})
+}
+
+// GetPartnerInventory fetches the full, paginated inventory of partnerID's appID/contextID
+// inventory through f.Session, the same classic endpoint the Steam community website itself
+// uses (/profiles/<steamid>/inventory/json/<appid>/<contextid>/). Authenticating the request
+// this way, instead of an unauthenticated *http.Client, is what lets it see private inventories.
+func (f *Fetcher) GetPartnerInventory(
+	ctx context.Context,
+	partnerID steamid.SteamID,
+	appID uint32,
+	contextID uint64,
+) (*Inventory, error) {
+	return f.GetFullInventory(ctx, func(start uint) (*http.Request, error) {
+		return NewInventoryRequest(ctx, f.Session, partnerID, appID, contextID, start)
+	})
+}
+
+// NewInventoryRequest builds the *http.Request for page start of steamID's appID/contextID
+// inventory, authenticated through session.
+func NewInventoryRequest(
+	ctx context.Context,
+	session Session,
+	steamID steamid.SteamID,
+	appID uint32,
+	contextID uint64,
+	start uint,
+) (*http.Request, error) {
+	path := fmt.Sprintf("/profiles/%s/inventory/json/%d/%d/", steamID.FormatString(), appID, contextID)
+
+	if start > 0 {
+		path += fmt.Sprintf("?start=%d", start)
+	}
+
+	return session.NewRequest(ctx, http.MethodGet, path, nil)
+}
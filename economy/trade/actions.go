@@ -1,10 +1,12 @@
 package trade
 
 import (
+	"context"
 	"time"
 
 	"github.com/13k/go-steam/economy/inventory"
 	"github.com/13k/go-steam/economy/trade/api"
+	"github.com/13k/go-steam/steamid"
 )
 
 type Slot uint
@@ -22,12 +24,30 @@ func (t *Trade) action(status *api.Result, err error) error {
 // If the latter is the case, this method may also sleep before the request to conform to the
 // polling interval of the official Steam client.
 func (t *Trade) Poll() ([]interface{}, error) {
+	return t.PollCtx(context.Background())
+}
+
+// PollCtx is like Poll, but honors ctx for the polling-interval sleep, returning ctx.Err() if ctx
+// is done before the sleep completes, and checks ctx again before issuing the underlying request.
+//
+// That check is a pre-flight check only, not real mid-flight cancellation: t.api isn't
+// context-aware, so a ctx that's cancelled after this check passes has no effect on the
+// in-flight request. Real cancellation needs context support in the api package.
+func (t *Trade) PollCtx(ctx context.Context) ([]interface{}, error) {
 	if t.queuedEvents != nil {
 		return t.Events(), nil
 	}
 
 	if d := time.Since(t.lastPoll); d < pollTimeout {
-		time.Sleep(pollTimeout - d)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(pollTimeout - d):
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
 	}
 
 	t.lastPoll = time.Now()
@@ -40,16 +60,85 @@ func (t *Trade) Poll() ([]interface{}, error) {
 }
 
 func (t *Trade) GetTheirInventory(contextID uint64, appID uint32) (*inventory.Inventory, error) {
-	return inventory.GetFullInventory(func(start uint) (*inventory.PartialInventory, error) {
-		return t.api.GetForeignInventory(contextID, appID, start)
-	})
+	return t.GetTheirInventoryCtx(context.Background(), contextID, appID)
+}
+
+// GetTheirInventoryCtx is like GetTheirInventory, but checks ctx between pages and stops the
+// paginated fetch once ctx is done. t.api isn't context-aware, so a page request already in
+// flight is not cancelled.
+func (t *Trade) GetTheirInventoryCtx(
+	ctx context.Context,
+	contextID uint64,
+	appID uint32,
+) (*inventory.Inventory, error) {
+	return inventory.GetFullInventoryCtx(
+		ctx,
+		func(_ context.Context, start uint) (*inventory.PartialInventory, error) {
+			return t.api.GetForeignInventory(contextID, appID, start)
+		},
+	)
+}
+
+// GetTheirSessionInventory is like GetTheirInventory, but fetches partnerID's inventory through
+// session (usually a *steam.Web, see steam.Web.Inventory) instead of t.api.GetForeignInventory.
+// Prefer this over GetTheirInventory when the partner's inventory is private: an unauthenticated
+// request to a private inventory silently comes back empty instead of erroring.
+func (t *Trade) GetTheirSessionInventory(
+	session inventory.Session,
+	partnerID steamid.SteamID,
+	contextID uint64,
+	appID uint32,
+) (*inventory.Inventory, error) {
+	return t.GetTheirSessionInventoryCtx(context.Background(), session, partnerID, contextID, appID)
+}
+
+// GetTheirSessionInventoryCtx is like GetTheirSessionInventory, but aborts as soon as ctx is done
+// instead of blocking through the remainder of the paginated fetch.
+func (t *Trade) GetTheirSessionInventoryCtx(
+	ctx context.Context,
+	session inventory.Session,
+	partnerID steamid.SteamID,
+	contextID uint64,
+	appID uint32,
+) (*inventory.Inventory, error) {
+	return inventory.NewFetcher(session).GetPartnerInventory(ctx, partnerID, appID, contextID)
 }
 
 func (t *Trade) GetOwnInventory(contextID uint64, appID uint32) (*inventory.Inventory, error) {
+	return t.GetOwnInventoryCtx(context.Background(), contextID, appID)
+}
+
+// GetOwnInventoryCtx is like GetOwnInventory, but checks ctx before issuing the request.
+//
+// This is a pre-flight check only, not real mid-flight cancellation: t.api isn't context-aware,
+// so a ctx that's cancelled after this check passes has no effect on the in-flight request. Real
+// cancellation needs context support in the api package.
+func (t *Trade) GetOwnInventoryCtx(
+	ctx context.Context,
+	contextID uint64,
+	appID uint32,
+) (*inventory.Inventory, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	return t.api.GetOwnInventory(contextID, appID)
 }
 
 func (t *Trade) GetMain() (*api.Main, error) {
+	return t.GetMainCtx(context.Background())
+}
+
+// GetMainCtx is like GetMain, but checks ctx before issuing the request.
+//
+// This is a pre-flight check only, not real mid-flight cancellation: t.api isn't context-aware,
+// so a ctx that's cancelled after this check passes has no effect on the in-flight request. Real
+// cancellation needs context support in the api package.
+func (t *Trade) GetMainCtx(ctx context.Context) (*api.Main, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	return t.api.GetMain()
 }
 
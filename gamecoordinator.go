@@ -2,6 +2,9 @@ package steam
 
 import (
 	"bytes"
+	"context"
+	"sync"
+	"time"
 
 	pb "github.com/13k/go-steam-resources/protobuf/steam"
 	"github.com/13k/go-steam-resources/steamlang"
@@ -10,15 +13,31 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+// gcRouteKey identifies messages for a single app and message type, which is as granular as
+// GCPacket currently lets us route without a job ID (see RegisterMessageHandler and Send).
+type gcRouteKey struct {
+	appID   uint32
+	msgType uint32
+}
+
 type GameCoordinator struct {
-	client   *Client
-	handlers []GCPacketHandler
+	client *Client
+
+	mu            sync.Mutex
+	handlers      []GCPacketHandler
+	appHandlers   map[uint32][]GCPacketHandler
+	msgHandlers   map[gcRouteKey]map[uint64]func(*gc.GCPacket)
+	waiters       map[gcRouteKey][]chan *gc.GCPacket
+	nextHandlerID uint64
 }
 
 func newGC(client *Client) *GameCoordinator {
 	return &GameCoordinator{
-		client:   client,
-		handlers: make([]GCPacketHandler, 0),
+		client:      client,
+		handlers:    make([]GCPacketHandler, 0),
+		appHandlers: make(map[uint32][]GCPacketHandler),
+		msgHandlers: make(map[gcRouteKey]map[uint64]func(*gc.GCPacket)),
+		waiters:     make(map[gcRouteKey][]chan *gc.GCPacket),
 	}
 }
 
@@ -26,10 +45,58 @@ type GCPacketHandler interface {
 	HandleGCPacket(*gc.GCPacket)
 }
 
+// RegisterPacketHandler registers a catch-all handler that receives every GC packet, regardless
+// of AppID. Prefer RegisterAppHandler or RegisterMessageHandler for new code.
 func (g *GameCoordinator) RegisterPacketHandler(handler GCPacketHandler) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
 	g.handlers = append(g.handlers, handler)
 }
 
+// RegisterAppHandler registers handler to receive only the GC packets sent for appID, instead of
+// every handler having to switch on AppID itself.
+func (g *GameCoordinator) RegisterAppHandler(appID uint32, handler GCPacketHandler) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.appHandlers[appID] = append(g.appHandlers[appID], handler)
+}
+
+// RegisterMessageHandler registers fn to be called for packets of msgType sent for appID. The
+// returned func removes the registration.
+func (g *GameCoordinator) RegisterMessageHandler(
+	appID uint32,
+	msgType uint32,
+	fn func(*gc.GCPacket),
+) (unregister func()) {
+	key := gcRouteKey{appID: appID, msgType: msgType}
+
+	g.mu.Lock()
+
+	id := g.nextHandlerID
+	g.nextHandlerID++
+
+	if g.msgHandlers[key] == nil {
+		g.msgHandlers[key] = make(map[uint64]func(*gc.GCPacket))
+	}
+
+	g.msgHandlers[key][id] = fn
+
+	g.mu.Unlock()
+
+	return func() {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+
+		delete(g.msgHandlers[key], id)
+
+		if len(g.msgHandlers[key]) == 0 {
+			delete(g.msgHandlers, key)
+		}
+	}
+}
+
 func (g *GameCoordinator) HandlePacket(packet *protocol.Packet) {
 	if packet.EMsg != steamlang.EMsg_ClientFromGC {
 		return
@@ -46,7 +113,49 @@ func (g *GameCoordinator) HandlePacket(packet *protocol.Packet) {
 		return
 	}
 
-	for _, handler := range g.handlers {
+	g.dispatch(p)
+}
+
+func (g *GameCoordinator) dispatch(p *gc.GCPacket) {
+	key := gcRouteKey{appID: p.AppId, msgType: p.MsgType}
+
+	g.mu.Lock()
+
+	var waiter chan *gc.GCPacket
+
+	if waiting := g.waiters[key]; len(waiting) > 0 {
+		waiter = waiting[0]
+		g.waiters[key] = waiting[1:]
+
+		if len(g.waiters[key]) == 0 {
+			delete(g.waiters, key)
+		}
+	}
+
+	catchAll := append([]GCPacketHandler(nil), g.handlers...)
+	perApp := append([]GCPacketHandler(nil), g.appHandlers[p.AppId]...)
+
+	var perMsg []func(*gc.GCPacket)
+
+	for _, fn := range g.msgHandlers[key] {
+		perMsg = append(perMsg, fn)
+	}
+
+	g.mu.Unlock()
+
+	if waiter != nil {
+		waiter <- p
+	}
+
+	for _, handler := range perApp {
+		handler.HandleGCPacket(p)
+	}
+
+	for _, fn := range perMsg {
+		fn(p)
+	}
+
+	for _, handler := range catchAll {
 		handler.HandleGCPacket(p)
 	}
 }
@@ -69,6 +178,106 @@ func (g *GameCoordinator) Write(msg gc.IGCMsg) {
 	}))
 }
 
+// Send writes msg and blocks until a packet of type expect arrives for the same AppID, or ctx is
+// done. It is meant for the GC's request/reply message pairs (e.g. asking for an item schema and
+// waiting for the response).
+//
+// protocol/gamecoordinator (gc.GCPacket, gc.NewGCPacket) isn't in this source tree, so replies
+// can only be matched by (AppID, expect), not by a job ID. Concurrent Sends for the same
+// (AppID, expect) pair are queued FIFO and each is handed the next reply of that type in arrival
+// order, which is only correct if the GC itself replies to requests of the same type in the
+// order they were sent; it is not a substitute for real job-ID correlation, which needs
+// protocol/gamecoordinator to expose one.
+func (g *GameCoordinator) Send(ctx context.Context, msg gc.IGCMsg, expect uint32) (*gc.GCPacket, error) {
+	key := gcRouteKey{appID: msg.GetAppId(), msgType: expect}
+	ch := make(chan *gc.GCPacket, 1)
+
+	g.mu.Lock()
+	g.waiters[key] = append(g.waiters[key], ch)
+	g.mu.Unlock()
+
+	g.Write(msg)
+
+	select {
+	case <-ctx.Done():
+		g.removeWaiter(key, ch)
+		return nil, ctx.Err()
+	case p := <-ch:
+		return p, nil
+	}
+}
+
+func (g *GameCoordinator) removeWaiter(key gcRouteKey, ch chan *gc.GCPacket) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	waiting := g.waiters[key]
+
+	for i, w := range waiting {
+		if w == ch {
+			g.waiters[key] = append(waiting[:i], waiting[i+1:]...)
+			break
+		}
+	}
+
+	if len(g.waiters[key]) == 0 {
+		delete(g.waiters, key)
+	}
+}
+
+// Hello starts a goroutine that repeatedly sends k_EMsgGCClientHello for appID, at the given
+// interval, and returns immediately. The goroutine stops once either a k_EMsgGCClientWelcome is
+// observed for appID (at which point it emits GCReadyEvent{appID}) or ctx is done.
+func (g *GameCoordinator) Hello(ctx context.Context, appID uint32, interval time.Duration) {
+	welcome := make(chan struct{}, 1)
+
+	unregister := g.RegisterMessageHandler(
+		appID,
+		uint32(steamlang.EGCBaseClientMsg_ClientWelcome),
+		func(*gc.GCPacket) {
+			select {
+			case welcome <- struct{}{}:
+			default:
+			}
+		},
+	)
+
+	go func() {
+		defer unregister()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		g.writeHello(appID)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-welcome:
+				g.client.Emit(&GCReadyEvent{AppID: appID})
+				return
+			case <-ticker.C:
+				g.writeHello(appID)
+			}
+		}
+	}()
+}
+
+func (g *GameCoordinator) writeHello(appID uint32) {
+	g.client.Write(protocol.NewClientMsgProtobuf(steamlang.EMsg_ClientToGC, &pb.CMsgGCClient{
+		Msgtype: proto.Uint32(uint32(steamlang.EGCBaseClientMsg_ClientHello) | 0x80000000),
+		Appid:   proto.Uint32(appID),
+		Payload: []byte{},
+	}))
+}
+
+// GCReadyEvent is emitted once the GC has acknowledged a ClientHello for AppID with a
+// ClientWelcome, meaning it is ready to receive further app-specific messages.
+type GCReadyEvent struct {
+	AppID uint32
+}
+
 // Sets you in the given games. Specify none to quit all games.
 func (g *GameCoordinator) SetGamesPlayed(appIds ...uint64) {
 	games := make([]*pb.CMsgClientGamesPlayed_GamePlayed, 0)
@@ -0,0 +1,119 @@
+package steam
+
+import (
+	"crypto/sha1"
+	"io"
+
+	pb "github.com/13k/go-steam-resources/protobuf/steam"
+	"github.com/13k/go-steam-resources/steamlang"
+	"github.com/13k/go-steam/protocol"
+	"google.golang.org/protobuf/proto"
+)
+
+// Sentry handles the Steam Guard "machine auth" (sentry file) handshake: Steam sends the bot a
+// blob to hash and store, then skips the email code on logons that present the matching hash in
+// LogOnDetails.SentryFileHash.
+type Sentry struct {
+	client *Client
+
+	// Storage is where the sentry blob sent by Steam is written to and read back from. It is
+	// usually backed by an *os.File opened for read/write, but any io.ReaderAt/io.WriterAt pair
+	// works (e.g. an in-memory buffer for tests).
+	Storage SentryStorage
+}
+
+// SentryStorage is the persistence the Sentry handler writes the machine auth blob to.
+type SentryStorage interface {
+	io.ReaderAt
+	io.WriterAt
+}
+
+var _ protocol.PacketHandler = (*Sentry)(nil)
+
+// NewSentry creates a Sentry handler that persists the machine auth blob to storage. Storage may
+// be nil if the caller only wants to observe MachineAuthUpdateEvent and persist the blob itself.
+func NewSentry(client *Client, storage SentryStorage) *Sentry {
+	return &Sentry{client: client, Storage: storage}
+}
+
+func (s *Sentry) HandlePacket(packet *protocol.Packet) {
+	if packet.EMsg() != steamlang.EMsg_ClientUpdateMachineAuth {
+		return
+	}
+
+	msg := &pb.CMsgClientUpdateMachineAuth{}
+
+	if _, err := packet.ReadProtoMsg(msg); err != nil {
+		s.client.Errorf("sentry: error reading message: %v", err)
+		return
+	}
+
+	hash := sha1.Sum(msg.GetBytes())
+	cubWrote := int32(len(msg.GetBytes()))
+
+	if s.Storage != nil {
+		if _, err := s.Storage.WriteAt(msg.GetBytes(), int64(msg.GetOffset())); err != nil {
+			s.client.Errorf("sentry: error writing machine auth blob: %v", err)
+			return
+		}
+	}
+
+	s.client.Emit(&MachineAuthUpdateEvent{
+		Hash:     hash[:],
+		Offset:   msg.GetOffset(),
+		Filename: msg.GetFilename(),
+	})
+
+	response := &pb.CMsgClientUpdateMachineAuthResponse{
+		ShaFile:  hash[:],
+		Offset:   proto.Int32(msg.GetOffset()),
+		Cubwrote: proto.Int32(cubWrote),
+		Filename: proto.String(msg.GetFilename()),
+	}
+
+	s.client.Write(protocol.NewProtoMessage(steamlang.EMsg_ClientUpdateMachineAuthResponse, response))
+}
+
+// MachineAuthUpdateEvent is emitted whenever Steam sends a new (or updated) sentry blob. Callers
+// that want the bypass-Steam-Guard behavior on future logons should persist Hash and pass it as
+// LogOnDetails.SentryFileHash.
+type MachineAuthUpdateEvent struct {
+	Hash     []byte
+	Offset   int32
+	Filename string
+}
+
+// LogOnDetails holds the credentials and options for a Client logon request (EMsg_ClientLogon).
+type LogOnDetails struct {
+	Username string
+	Password string
+
+	// AuthCode is the Steam Guard email code. Not required if SentryFileHash already identifies
+	// this machine to Steam.
+	AuthCode string
+
+	// SentryFileHash is the SHA1 hash of a previously saved sentry (machine auth) blob — see
+	// MachineAuthUpdateEvent. Supplying it lets Steam recognize this machine and skip the Steam
+	// Guard email code on this logon.
+	SentryFileHash []byte
+}
+
+// BuildClientLogonMsg builds the CMsgClientLogon payload for details, forwarding SentryFileHash
+// as ShaSentryfile so Steam can recognize this machine and skip the email code. Client.LogOn must
+// call this (instead of constructing CMsgClientLogon itself) for SentryFileHash to take effect.
+func BuildClientLogonMsg(details *LogOnDetails) *pb.CMsgClientLogon {
+	msg := &pb.CMsgClientLogon{
+		AccountName: proto.String(details.Username),
+		Password:    proto.String(details.Password),
+	}
+
+	if details.AuthCode != "" {
+		msg.AuthCode = proto.String(details.AuthCode)
+	}
+
+	if len(details.SentryFileHash) > 0 {
+		msg.ShaSentryfile = details.SentryFileHash
+	}
+
+	return msg
+}
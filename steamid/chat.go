@@ -0,0 +1,44 @@
+package steamid
+
+import (
+	"github.com/13k/go-steam-resources/steamlang"
+)
+
+// chatInstanceFlagClan marks a SteamID's account-instance field as referring to a clan's chat
+// room rather than the clan itself. It occupies bit 19 of the 20-bit account-instance field,
+// same as SteamKit's EChatSteamIDInstanceFlags.Clan.
+const chatInstanceFlagClan uint64 = 0x80000
+
+const (
+	accountInstanceMask  = 0x000FFFFF
+	accountInstanceShift = 32
+	accountTypeMask      = 0xF
+	accountTypeShift     = 52
+)
+
+// ClanToChat converts a clan SteamID into the SteamID of that clan's chat room, i.e. the ID a
+// ChatMsgEvent carries for messages sent in the clan chat rather than a direct message.
+func (s SteamID) ClanToChat() SteamID {
+	v := uint64(s)
+
+	v &^= uint64(accountInstanceMask) << accountInstanceShift
+	v |= chatInstanceFlagClan << accountInstanceShift
+
+	v &^= uint64(accountTypeMask) << accountTypeShift
+	v |= uint64(steamlang.EAccountType_Chat) << accountTypeShift
+
+	return SteamID(v)
+}
+
+// ChatToClan is the inverse of ClanToChat: it converts a clan's chat-room SteamID back into the
+// SteamID of the clan itself.
+func (s SteamID) ChatToClan() SteamID {
+	v := uint64(s)
+
+	v &^= uint64(accountInstanceMask) << accountInstanceShift
+
+	v &^= uint64(accountTypeMask) << accountTypeShift
+	v |= uint64(steamlang.EAccountType_Clan) << accountTypeShift
+
+	return SteamID(v)
+}
@@ -1,6 +1,7 @@
 package steam
 
 import (
+	"context"
 	"crypto/aes"
 	"crypto/rand"
 	"encoding/base64"
@@ -10,7 +11,9 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"sync/atomic"
+	"time"
 
 	pb "github.com/13k/go-steam-resources/protobuf/steam"
 	"github.com/13k/go-steam-resources/steamlang"
@@ -19,6 +22,16 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+// apiLogOnRetries is the number of times LogOn attempts apiLogOn before giving up.
+const apiLogOnRetries = 3
+
+// apiLogOnBackoff is the base delay between apiLogOn retries; it doubles after each attempt.
+const apiLogOnBackoff = 1 * time.Second
+
+// defaultUserAgent is sent with every request issued through Web. Several steamcommunity.com
+// endpoints 403 requests that don't look like they came from the Steam client or a browser.
+const defaultUserAgent = "Valve/Steam HTTP Client 1.0"
+
 type Web struct {
 	// 64 bit alignment
 	relogOnNonce uint32
@@ -37,12 +50,44 @@ type Web struct {
 
 	webLoginKey string
 	client      *Client
+	httpClient  *http.Client
+	userAgent   string
+
+	// relogOnCtx is the context passed to the LogOn call that is replayed when Steam asks us to
+	// relogin with a fresh nonce (see handleAuthNonceResponse). It is only read/written while
+	// relogOnNonce guards against concurrent relogins.
+	relogOnCtx context.Context
 }
 
 var _ protocol.PacketHandler = (*Web)(nil)
 
-func NewWeb(client *Client) *Web {
-	return &Web{client: client}
+// WebOption configures a Web instance created by NewWeb.
+type WebOption func(*Web)
+
+// WithHTTPClient sets the *http.Client used for all outgoing requests, e.g. to configure
+// transport-level timeouts or a proxy. Defaults to http.DefaultClient.
+func WithHTTPClient(httpClient *http.Client) WebOption {
+	return func(w *Web) {
+		w.httpClient = httpClient
+	}
+}
+
+// WithUserAgent overrides the User-Agent sent with every request issued through Web. Defaults to
+// defaultUserAgent.
+func WithUserAgent(userAgent string) WebOption {
+	return func(w *Web) {
+		w.userAgent = userAgent
+	}
+}
+
+func NewWeb(client *Client, options ...WebOption) *Web {
+	w := &Web{client: client, httpClient: http.DefaultClient, userAgent: defaultUserAgent}
+
+	for _, option := range options {
+		option(w)
+	}
+
+	return w
 }
 
 func (w *Web) HandlePacket(packet *protocol.Packet) {
@@ -58,32 +103,45 @@ func (w *Web) HandlePacket(packet *protocol.Packet) {
 //
 // Returns an error if called before the first WebSessionIdEvent.
 func (w *Web) LogOn() error {
+	return w.LogOnCtx(context.Background())
+}
+
+// LogOnCtx is like LogOn, but retries with an exponential backoff that honors ctx instead of
+// a fixed, uncancelable sleep between attempts.
+func (w *Web) LogOnCtx(ctx context.Context) error {
 	if w.webLoginKey == "" {
 		return errors.New("steam/web: session not initialized")
 	}
 
 	go func() {
-		// retry three times. yes, I know about loops.
-		err := w.apiLogOn()
+		var err error
+
+		backoff := apiLogOnBackoff
 
-		if err != nil {
-			err = w.apiLogOn()
+		for attempt := 0; attempt < apiLogOnRetries; attempt++ {
+			if attempt > 0 {
+				select {
+				case <-ctx.Done():
+					w.client.Emit(WebLogOnErrorEvent(ctx.Err()))
+					return
+				case <-time.After(backoff):
+				}
 
-			if err != nil {
-				err = w.apiLogOn()
+				backoff *= 2
 			}
-		}
 
-		if err != nil {
-			w.client.Emit(WebLogOnErrorEvent(err))
-			return
+			if err = w.apiLogOn(ctx); err == nil {
+				return
+			}
 		}
+
+		w.client.Emit(WebLogOnErrorEvent(err))
 	}()
 
 	return nil
 }
 
-func (w *Web) apiLogOn() error {
+func (w *Web) apiLogOn(ctx context.Context) error {
 	sessionKey := make([]byte, 32)
 
 	if _, err := rand.Read(sessionKey); err != nil {
@@ -114,7 +172,20 @@ func (w *Web) apiLogOn() error {
 	data.Add("sessionkey", string(cryptedSessionKey))
 	data.Add("encrypted_loginkey", string(cryptedLoginKey))
 
-	resp, err := http.PostForm("https://api.steampowered.com/ISteamUserAuth/AuthenticateUser/v0001", data)
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		"https://api.steampowered.com/ISteamUserAuth/AuthenticateUser/v0001",
+		strings.NewReader(data.Encode()),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := w.httpClient.Do(req)
 
 	if err != nil {
 		return err
@@ -123,6 +194,7 @@ func (w *Web) apiLogOn() error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode == 401 { // our web login key has expired, request a new one
+		w.relogOnCtx = ctx
 		atomic.StoreUint32(&w.relogOnNonce, 1)
 
 		pbmsg := &pb.CMsgClientRequestWebAPIAuthenticateUserNonce{}
@@ -190,7 +262,12 @@ func (w *Web) handleAuthNonceResponse(packet *protocol.Packet) {
 
 	// if the nonce was specifically requested in apiLogOn(), don't emit an event.
 	if atomic.CompareAndSwapUint32(&w.relogOnNonce, 1, 0) {
-		if err := w.LogOn(); err != nil {
+		if err := w.relogOnCtx.Err(); err != nil {
+			w.client.Errorf("web: not relogging on, context is done: %v", err)
+			return
+		}
+
+		if err := w.LogOnCtx(w.relogOnCtx); err != nil {
 			w.client.Errorf("web: error logging on: %v", err)
 			return
 		}
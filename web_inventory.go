@@ -0,0 +1,50 @@
+package steam
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/13k/go-steam/economy/inventory"
+)
+
+// steamCommunityBaseURL is where relative paths passed to NewRequest are resolved against.
+const steamCommunityBaseURL = "https://steamcommunity.com"
+
+var _ inventory.Session = (*Web)(nil)
+
+// NewRequest builds an *http.Request carrying the session's cookies and User-Agent. path is
+// resolved against steamCommunityBaseURL unless it already starts with a scheme.
+func (w *Web) NewRequest(ctx context.Context, method, path string, body io.Reader) (*http.Request, error) {
+	url := path
+
+	if !strings.HasPrefix(path, "http://") && !strings.HasPrefix(path, "https://") {
+		url = steamCommunityBaseURL + path
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("User-Agent", w.userAgent)
+
+	req.AddCookie(&http.Cookie{Name: "sessionid", Value: w.SessionID})
+	req.AddCookie(&http.Cookie{Name: "steamLogin", Value: w.SteamLogin})
+	req.AddCookie(&http.Cookie{Name: "steamLoginSecure", Value: w.SteamLoginSecure})
+
+	return req, nil
+}
+
+// Do executes req using Web's underlying *http.Client.
+func (w *Web) Do(req *http.Request) (*http.Response, error) {
+	return w.httpClient.Do(req)
+}
+
+// Inventory returns a ready-to-use inventory.Fetcher authenticated with this Web session, so
+// callers don't have to build cookie-carrying requests by hand.
+func (w *Web) Inventory() *inventory.Fetcher {
+	return inventory.NewFetcher(w)
+}